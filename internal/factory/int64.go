@@ -0,0 +1,15 @@
+package factory
+
+import "github.com/hashicorp/terraform-plugin-framework/types"
+
+// Int64PtrToIntPtr converts a Terraform Int64 value into the *int pointer
+// the repoflow client expects, mirroring IntPtrToInt64Ptr for the opposite
+// direction. A null or unknown value converts to nil.
+func Int64PtrToIntPtr(v types.Int64) *int {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+
+	i := int(v.ValueInt64())
+	return &i
+}
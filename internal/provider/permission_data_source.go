@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &PermissionDataSource{}
+
+func NewPermissionDataSource() datasource.DataSource {
+	return &PermissionDataSource{}
+}
+
+// PermissionDataSource looks up the role currently granted to a principal on
+// a workspace or, when repository is set, a single repository within it.
+type PermissionDataSource struct {
+	client *repoflow.Client
+}
+
+type PermissionDataSourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Workspace     types.String `tfsdk:"workspace"`
+	Repository    types.String `tfsdk:"repository"`
+	PrincipalType types.String `tfsdk:"principal_type"`
+	PrincipalId   types.String `tfsdk:"principal_id"`
+	Role          types.String `tfsdk:"role"`
+}
+
+func (d *PermissionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission"
+}
+
+func (d *PermissionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Permission data source",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace the permission applies to (name or Id)",
+				Required:            true,
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository the permission applies to (name or Id). When unset, the permission is workspace-wide.",
+				Optional:            true,
+			},
+			"principal_type": schema.StringAttribute{
+				MarkdownDescription: "Kind of principal the permission is granted to. One of `user`, `group` or `token`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("user", "group", "token"),
+				},
+			},
+			"principal_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the principal (user, group or token) the permission is granted to.",
+				Required:            true,
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role currently granted to the principal.",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Permission identifier (`workspace/repository/principal_type/principal_id`)",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *PermissionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*repoflow.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *repoflow.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *PermissionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data PermissionDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ws, err := d.client.GetWorkspace(data.Workspace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", data.Workspace.ValueString(), err))
+		return
+	}
+
+	var repositoryId string
+	if !data.Repository.IsNull() && data.Repository.ValueString() != "" {
+		rp, err := d.client.GetRepository(ws.Id, data.Repository.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+				"Unable to get repository %s on workspaceId %s, got error: %s", data.Repository.ValueString(), ws.Id, err,
+			))
+			return
+		}
+		repositoryId = rp.Id
+	}
+
+	permission, err := d.client.GetPermission(ws.Id, repositoryId, data.PrincipalType.ValueString(), data.PrincipalId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permission, got error: %s", err))
+		return
+	}
+
+	data.Role = types.StringValue(permission.Role)
+	data.Id = types.StringValue(permissionId(ws.Id, repositoryId, data.PrincipalType.ValueString(), data.PrincipalId.ValueString()))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read permission data", map[string]interface{}{
+		"id": data.Id.ValueString(),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
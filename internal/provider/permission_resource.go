@@ -0,0 +1,279 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &PermissionResource{}
+var _ resource.ResourceWithImportState = &PermissionResource{}
+
+func NewPermissionResource() resource.Resource {
+	return &PermissionResource{}
+}
+
+// PermissionResource manages the (principal, workspace, repository, role)
+// tuple that grants a user, group or token a role on a workspace or, when
+// repository is set, a single repository within it.
+type PermissionResource struct {
+	client *repoflow.Client
+}
+
+type PermissionResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	Workspace     types.String `tfsdk:"workspace"`
+	Repository    types.String `tfsdk:"repository"`
+	PrincipalType types.String `tfsdk:"principal_type"`
+	PrincipalId   types.String `tfsdk:"principal_id"`
+	Role          types.String `tfsdk:"role"`
+}
+
+func (r *PermissionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_permission"
+}
+
+func (r *PermissionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Grants a principal a role on a workspace, or on a single repository within it.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace the permission applies to (name or Id)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository": schema.StringAttribute{
+				MarkdownDescription: "Repository the permission applies to (name or Id). When unset, the permission is workspace-wide.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_type": schema.StringAttribute{
+				MarkdownDescription: "Kind of principal the permission is granted to. One of `user`, `group` or `token`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("user", "group", "token"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the principal (user, group or token) the permission is granted to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"role": schema.StringAttribute{
+				MarkdownDescription: "Role granted to the principal. One of `reader`, `writer` or `admin`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("reader", "writer", "admin"),
+				},
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Permission identifier (`workspace/repository/principal_type/principal_id`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PermissionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*repoflow.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *repoflow.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PermissionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PermissionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := r.resolveTargets(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve workspace/repository, got error: %s", err))
+		return
+	}
+
+	_, err = r.client.CreatePermission(workspaceId, repositoryId, data.PrincipalType.ValueString(), data.PrincipalId.ValueString(), data.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create permission, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(permissionId(workspaceId, repositoryId, data.PrincipalType.ValueString(), data.PrincipalId.ValueString()))
+
+	tflog.Trace(ctx, "created permission", map[string]interface{}{"id": data.Id.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PermissionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PermissionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := r.resolveTargets(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve workspace/repository, got error: %s", err))
+		return
+	}
+
+	permission, err := r.client.GetPermission(workspaceId, repositoryId, data.PrincipalType.ValueString(), data.PrincipalId.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			// Revoked out-of-band: drop it from state instead of failing the plan.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read permission, got error: %s", err))
+		return
+	}
+
+	data.Role = types.StringValue(permission.Role)
+	data.Id = types.StringValue(permissionId(workspaceId, repositoryId, data.PrincipalType.ValueString(), data.PrincipalId.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PermissionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PermissionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := r.resolveTargets(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve workspace/repository, got error: %s", err))
+		return
+	}
+
+	_, err = r.client.UpdatePermission(workspaceId, repositoryId, data.PrincipalType.ValueString(), data.PrincipalId.ValueString(), data.Role.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update permission, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(permissionId(workspaceId, repositoryId, data.PrincipalType.ValueString(), data.PrincipalId.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PermissionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PermissionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId, repositoryId, err := r.resolveTargets(data)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve workspace/repository, got error: %s", err))
+		return
+	}
+
+	err = r.client.DeletePermission(workspaceId, repositoryId, data.PrincipalType.ValueString(), data.PrincipalId.ValueString())
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete permission, got error: %s", err))
+		return
+	}
+}
+
+func (r *PermissionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 4 {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: workspace/repository/principal_type/principal_id, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), parts[0])...)
+	if parts[1] != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), parts[1])...)
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal_type"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal_id"), parts[3])...)
+}
+
+// resolveTargets resolves the workspace and, if set, repository name/Id in
+// data down to the Ids the repoflow API expects. repositoryId is empty for
+// workspace-wide permissions.
+func (r *PermissionResource) resolveTargets(data PermissionResourceModel) (workspaceId string, repositoryId string, err error) {
+	ws, err := r.client.GetWorkspace(data.Workspace.ValueString())
+	if err != nil {
+		return "", "", err
+	}
+	workspaceId = ws.Id
+
+	if data.Repository.IsNull() || data.Repository.ValueString() == "" {
+		return workspaceId, "", nil
+	}
+
+	rp, err := r.client.GetRepository(workspaceId, data.Repository.ValueString())
+	if err != nil {
+		return "", "", err
+	}
+
+	return workspaceId, rp.Id, nil
+}
+
+func permissionId(workspaceId, repositoryId, principalType, principalId string) string {
+	return strings.Join([]string{workspaceId, repositoryId, principalType, principalId}, "/")
+}
+
+// isNotFoundError reports whether err is a repoflow API error with a 404
+// status, as opposed to a transient or unrelated failure that merely
+// mentions "not found" in its message.
+func isNotFoundError(err error) bool {
+	var apiErr *repoflow.APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
@@ -0,0 +1,100 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+func TestAccPermissionResource_basic(t *testing.T) {
+	workspace := os.Getenv("REPOFLOW_TEST_WORKSPACE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read back.
+			{
+				Config: testAccPermissionResourceConfig(workspace, "user", "acc-test-user", "reader"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("repoflow_permission.test", "workspace", workspace),
+					resource.TestCheckResourceAttr("repoflow_permission.test", "principal_type", "user"),
+					resource.TestCheckResourceAttr("repoflow_permission.test", "principal_id", "acc-test-user"),
+					resource.TestCheckResourceAttr("repoflow_permission.test", "role", "reader"),
+					resource.TestCheckResourceAttrSet("repoflow_permission.test", "id"),
+				),
+			},
+			// ImportState testing.
+			{
+				ResourceName:      "repoflow_permission.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update role in place.
+			{
+				Config: testAccPermissionResourceConfig(workspace, "user", "acc-test-user", "writer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("repoflow_permission.test", "role", "writer"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPermissionResource_outOfBandRevoke(t *testing.T) {
+	workspace := os.Getenv("REPOFLOW_TEST_WORKSPACE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionResourceConfig(workspace, "user", "acc-test-revoke", "reader"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("repoflow_permission.test", "role", "reader"),
+				),
+			},
+			// Revoke directly against the API, bypassing Terraform: the
+			// resource's Read must drop it from state instead of erroring,
+			// so the next plan proposes recreating it rather than failing.
+			{
+				PreConfig:          func() { revokeTestPermission(t, workspace, "user", "acc-test-revoke") },
+				Config:             testAccPermissionResourceConfig(workspace, "user", "acc-test-revoke", "reader"),
+				ExpectNonEmptyPlan: true,
+				PlanOnly:           true,
+			},
+		},
+	})
+}
+
+func testAccPermissionResourceConfig(workspace, principalType, principalId, role string) string {
+	return fmt.Sprintf(`
+resource "repoflow_permission" "test" {
+  workspace      = %[1]q
+  principal_type = %[2]q
+  principal_id   = %[3]q
+  role           = %[4]q
+}
+`, workspace, principalType, principalId, role)
+}
+
+// revokeTestPermission deletes a permission directly through the repoflow
+// client, simulating it being revoked out-of-band from Terraform.
+func revokeTestPermission(t *testing.T, workspace, principalType, principalId string) {
+	t.Helper()
+
+	client := repoflow.NewClient(os.Getenv("REPOFLOW_URL"), os.Getenv("REPOFLOW_TOKEN"))
+
+	ws, err := client.GetWorkspace(workspace)
+	if err != nil {
+		t.Fatalf("unable to get workspace %s: %s", workspace, err)
+	}
+
+	if err := client.DeletePermission(ws.Id, "", principalType, principalId); err != nil {
+		t.Fatalf("unable to revoke permission out-of-band: %s", err)
+	}
+}
@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// testAccProtoV6ProviderFactories is used to instantiate a provider during
+// acceptance testing. The factory function is called for each Terraform CLI
+// command to create a provider server that the CLI can connect to and
+// interact with.
+var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"repoflow": providerserver.NewProtocol6WithError(New("test")()),
+}
+
+// testAccPreCheck validates the necessary test API keys exist in the
+// testing environment.
+func testAccPreCheck(t *testing.T) {
+	if os.Getenv("REPOFLOW_URL") == "" {
+		t.Fatal("REPOFLOW_URL must be set for acceptance tests")
+	}
+	if os.Getenv("REPOFLOW_TOKEN") == "" {
+		t.Fatal("REPOFLOW_TOKEN must be set for acceptance tests")
+	}
+	if os.Getenv("REPOFLOW_TEST_WORKSPACE") == "" {
+		t.Fatal("REPOFLOW_TEST_WORKSPACE must be set for acceptance tests")
+	}
+}
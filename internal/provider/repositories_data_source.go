@@ -0,0 +1,344 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+	"github.com/fe80/terraform-provider-repoflow/internal/factory"
+	"github.com/fe80/terraform-provider-repoflow/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RepositoriesDataSource{}
+
+func NewRepositoriesDataSource() datasource.DataSource {
+	return &RepositoriesDataSource{}
+}
+
+// RepositoriesDataSource lists repositories, walking the repoflow API
+// pagination internally so the caller never has to deal with pages. When no
+// workspace filter is given, every workspace the caller can see is scanned.
+type RepositoriesDataSource struct {
+	client *repoflow.Client
+}
+
+type RepositoryListItemModel struct {
+	Name                              types.String `tfsdk:"name"`
+	Id                                types.String `tfsdk:"id"`
+	WorkspaceId                       types.String `tfsdk:"workspace"`
+	PackageType                       types.String `tfsdk:"package_type"`
+	RepositoryType                    types.String `tfsdk:"repository_type"`
+	RepositoryId                      types.String `tfsdk:"repository_id"`
+	RemoteRepositoryUrl               types.String `tfsdk:"remote_repository_url"`
+	RemoteCacheEnabled                types.Bool   `tfsdk:"remote_cache_enabled"`
+	FileCacheTimeTillRevalidation     types.Int64  `tfsdk:"file_cache_time_till_revalidation"`
+	MetadataCacheTimeTillRevalidation types.Int64  `tfsdk:"metadata_cache_time_till_revalidation"`
+	ChildRepositoryIds                types.List   `tfsdk:"child_repository_ids"`
+	UploadLocalRepositoryId           types.String `tfsdk:"upload_local_repository_id"`
+	Status                            types.String `tfsdk:"status"`
+}
+
+type RepositoriesDataSourceModel struct {
+	Workspace      types.String              `tfsdk:"workspace"`
+	PackageType    types.String              `tfsdk:"package_type"`
+	RepositoryType types.String              `tfsdk:"repository_type"`
+	NameRegex      types.String              `tfsdk:"name_regex"`
+	NamePrefix     types.String              `tfsdk:"name_prefix"`
+	Status         types.String              `tfsdk:"status"`
+	Id             types.String              `tfsdk:"id"`
+	Ids            types.List                `tfsdk:"ids"`
+	Repositories   []RepositoryListItemModel `tfsdk:"repositories"`
+}
+
+func (d *RepositoriesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repositories"
+}
+
+func (d *RepositoriesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists repositories, optionally filtered by workspace, type, name or status. When `workspace` is omitted, every workspace the caller can see is scanned.",
+
+		Attributes: map[string]schema.Attribute{
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Only include repositories from this workspace (name or Id). When unset, every workspace is scanned.",
+				Optional:            true,
+			},
+			"package_type": schema.StringAttribute{
+				MarkdownDescription: "Only include repositories of this package type.",
+				Optional:            true,
+				Validators:          validators.PackageTypeValidators(),
+			},
+			"repository_type": schema.StringAttribute{
+				MarkdownDescription: "Only include repositories of this type (local, remote or virtual).",
+				Optional:            true,
+				Validators:          validators.RepositoryTypeValidators(),
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only include repositories whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only include repositories whose name starts with this prefix.",
+				Optional:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Only include repositories with this status.",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the matched repositories, for use in `for_each`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of this data source call.",
+				Computed:            true,
+			},
+			"repositories": schema.ListNestedAttribute{
+				MarkdownDescription: "Matched repositories.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Repository name",
+							Computed:            true,
+						},
+						"workspace": schema.StringAttribute{
+							MarkdownDescription: "Workspace the repository belongs to (Id)",
+							Computed:            true,
+						},
+						"repository_type": schema.StringAttribute{
+							MarkdownDescription: "Repository type stored by the repository.",
+							Computed:            true,
+						},
+						"package_type": schema.StringAttribute{
+							MarkdownDescription: "Package type stored by the repository.",
+							Computed:            true,
+						},
+						"remote_repository_url": schema.StringAttribute{
+							MarkdownDescription: "URL of the remote repository (require for remote respository type).",
+							Computed:            true,
+						},
+						"remote_cache_enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether caching is enabled.",
+							Computed:            true,
+						},
+						"file_cache_time_till_revalidation": schema.Int64Attribute{
+							MarkdownDescription: "Milliseconds before cached files require revalidation (null for indefinite caching).",
+							Computed:            true,
+						},
+						"metadata_cache_time_till_revalidation": schema.Int64Attribute{
+							MarkdownDescription: "Milliseconds before cached metadata requires revalidation (null for indefinite caching).",
+							Computed:            true,
+						},
+						"child_repository_ids": schema.ListAttribute{
+							MarkdownDescription: "IDs of repositories included in the virtual repository. (require for virtual repository type)",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"upload_local_repository_id": schema.StringAttribute{
+							MarkdownDescription: "ID of a local repository where uploads will be stored (must also be in child_repository_ids)..",
+							Computed:            true,
+						},
+						"repository_id": schema.StringAttribute{
+							MarkdownDescription: "Repository identifier",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the repository",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Repository identifier",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *RepositoriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*repoflow.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *repoflow.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+// listWorkspaceIds resolves the set of workspace Ids to scan: either the
+// single workspace requested by the caller, or every workspace visible to
+// them when no workspace filter was set.
+func (d *RepositoriesDataSource) listWorkspaceIds(workspace string) ([]string, error) {
+	if workspace != "" {
+		ws, err := d.client.GetWorkspace(workspace)
+		if err != nil {
+			return nil, err
+		}
+		return []string{ws.Id}, nil
+	}
+
+	var ids []string
+	page := 1
+	for {
+		list, err := d.client.ListWorkspaces(page, 100)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ws := range list.Items {
+			ids = append(ids, ws.Id)
+		}
+
+		if !list.HasMore {
+			break
+		}
+		page++
+	}
+
+	return ids, nil
+}
+
+func (d *RepositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RepositoriesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid name_regex", fmt.Sprintf("Unable to compile name_regex, got error: %s", err))
+			return
+		}
+		nameRegex = re
+	}
+	namePrefix := data.NamePrefix.ValueString()
+	packageType := data.PackageType.ValueString()
+	repositoryType := data.RepositoryType.ValueString()
+	status := data.Status.ValueString()
+
+	workspaceIds, err := d.listWorkspaceIds(data.Workspace.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve workspaces, got error: %s", err))
+		return
+	}
+
+	var repositories []RepositoryListItemModel
+	var ids []string
+	for _, workspaceId := range workspaceIds {
+		page := 1
+		for {
+			list, err := d.client.ListRepositories(workspaceId, page, 100)
+			if err != nil {
+				resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+					"Unable to list repositories on workspaceId %s, got error: %s", workspaceId, err,
+				))
+				return
+			}
+
+			for _, rp := range list.Items {
+				if nameRegex != nil && !nameRegex.MatchString(rp.Name) {
+					continue
+				}
+				if namePrefix != "" && !strings.HasPrefix(rp.Name, namePrefix) {
+					continue
+				}
+				if packageType != "" && rp.PackageType != packageType {
+					continue
+				}
+				if repositoryType != "" && rp.RepositoryType != repositoryType {
+					continue
+				}
+				if status != "" && rp.Status != status {
+					continue
+				}
+
+				item := RepositoryListItemModel{
+					Name:                types.StringValue(rp.Name),
+					Id:                  types.StringValue(strings.Join([]string{workspaceId, rp.Id}, "/")),
+					WorkspaceId:         types.StringValue(workspaceId),
+					PackageType:         types.StringValue(rp.PackageType),
+					RepositoryType:      types.StringValue(rp.RepositoryType),
+					RepositoryId:        types.StringValue(rp.Id),
+					RemoteRepositoryUrl: types.StringPointerValue(rp.RemoteRepositoryUrl),
+					RemoteCacheEnabled:  types.BoolPointerValue(rp.IsRemoteCacheEnabled),
+					Status:              types.StringValue(rp.Status),
+				}
+
+				item.FileCacheTimeTillRevalidation = types.Int64PointerValue(factory.IntPtrToInt64Ptr(rp.FileCacheTimeTillRevalidation))
+				item.MetadataCacheTimeTillRevalidation = types.Int64PointerValue(factory.IntPtrToInt64Ptr(rp.MetadataCacheTimeTillRevalidation))
+				item.UploadLocalRepositoryId = types.StringPointerValue(rp.UploadLocalRepositoryId)
+
+				if rp.ChildRepositories == nil {
+					item.ChildRepositoryIds = types.ListNull(types.StringType)
+				} else {
+					childIds := make([]string, len(rp.ChildRepositories))
+					for i, child := range rp.ChildRepositories {
+						childIds[i] = child.Id
+					}
+
+					listValue, listDiags := types.ListValueFrom(ctx, types.StringType, childIds)
+					resp.Diagnostics.Append(listDiags...)
+					if resp.Diagnostics.HasError() {
+						return
+					}
+					item.ChildRepositoryIds = listValue
+				}
+
+				repositories = append(repositories, item)
+				ids = append(ids, item.Id.ValueString())
+			}
+
+			if !list.HasMore {
+				break
+			}
+			page++
+		}
+	}
+
+	data.Repositories = repositories
+	data.Id = types.StringValue("repositories")
+
+	idsValue, idsDiags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(idsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Ids = idsValue
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read repositories data", map[string]interface{}{
+		"count": len(repositories),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -176,7 +176,7 @@ func (d *RepositoryDataSource) Read(ctx context.Context, req datasource.ReadRequ
 
 	// Remote attributes
 	data.RemoteRepositoryUrl = types.StringPointerValue(rp.RemoteRepositoryUrl)
-	data.RemoteCacheEnabled = types.BoolValue(rp.IsRemoteCacheEnabled)
+	data.RemoteCacheEnabled = types.BoolPointerValue(rp.IsRemoteCacheEnabled)
 
 	// Cache attributes utilisant ton package factory
 	data.FileCacheTimeTillRevalidation = types.Int64PointerValue(factory.IntPtrToInt64Ptr(rp.FileCacheTimeTillRevalidation))
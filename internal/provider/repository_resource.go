@@ -0,0 +1,381 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+	"github.com/fe80/terraform-provider-repoflow/internal/factory"
+	"github.com/fe80/terraform-provider-repoflow/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RepositoryResource{}
+var _ resource.ResourceWithImportState = &RepositoryResource{}
+var _ resource.ResourceWithConfigValidators = &RepositoryResource{}
+
+func NewRepositoryResource() resource.Resource {
+	return &RepositoryResource{}
+}
+
+// RepositoryResource manages a repoflow repository.
+type RepositoryResource struct {
+	client *repoflow.Client
+}
+
+type RepositoryResourceModel struct {
+	Name                              types.String `tfsdk:"name"`
+	Id                                types.String `tfsdk:"id"`
+	WorkspaceId                       types.String `tfsdk:"workspace"`
+	PackageType                       types.String `tfsdk:"package_type"`
+	RepositoryType                    types.String `tfsdk:"repository_type"`
+	RepositoryId                      types.String `tfsdk:"repository_id"`
+	RemoteRepositoryUrl               types.String `tfsdk:"remote_repository_url"`
+	RemoteCacheEnabled                types.Bool   `tfsdk:"remote_cache_enabled"`
+	FileCacheTimeTillRevalidation     types.Int64  `tfsdk:"file_cache_time_till_revalidation"`
+	MetadataCacheTimeTillRevalidation types.Int64  `tfsdk:"metadata_cache_time_till_revalidation"`
+	ChildRepositoryIds                types.List   `tfsdk:"child_repository_ids"`
+	UploadLocalRepositoryId           types.String `tfsdk:"upload_local_repository_id"`
+	Status                            types.String `tfsdk:"status"`
+}
+
+func (r *RepositoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository"
+}
+
+func (r *RepositoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Repository resource",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Repository name",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace used to create it (name or Id)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"repository_type": schema.StringAttribute{
+				MarkdownDescription: "Repository type stored by the repository. One of `local`, `remote` or `virtual`.",
+				Required:            true,
+				Validators:          validators.RepositoryTypeValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"package_type": schema.StringAttribute{
+				MarkdownDescription: "Package type stored by the repository.",
+				Required:            true,
+				Validators:          validators.PackageTypeValidators(),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"remote_repository_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the remote repository (required for remote repository type).",
+				Optional:            true,
+			},
+			"remote_cache_enabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether caching is enabled.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"file_cache_time_till_revalidation": schema.Int64Attribute{
+				MarkdownDescription: "Milliseconds before cached files require revalidation (null for indefinite caching).",
+				Optional:            true,
+				Validators:          validators.CacheTimeTillRevalidationValidators(),
+			},
+			"metadata_cache_time_till_revalidation": schema.Int64Attribute{
+				MarkdownDescription: "Milliseconds before cached metadata requires revalidation (null for indefinite caching).",
+				Optional:            true,
+				Validators:          validators.CacheTimeTillRevalidationValidators(),
+			},
+			"child_repository_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of repositories included in the virtual repository. (required for virtual repository type)",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"upload_local_repository_id": schema.StringAttribute{
+				MarkdownDescription: "ID of a local repository where uploads will be stored (must also be in child_repository_ids).",
+				Optional:            true,
+			},
+			"repository_id": schema.StringAttribute{
+				MarkdownDescription: "Repository identifier",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the repository",
+				Computed:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Repository identifier (`workspace/repository_id`)",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+// ConfigValidators enforces the cross-field rules between repository_type,
+// remote_repository_url, child_repository_ids and upload_local_repository_id
+// that the individual attribute validators above can't express.
+func (r *RepositoryResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return validators.RepositoryConfigValidators()
+}
+
+func (r *RepositoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*repoflow.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *repoflow.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *RepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data RepositoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ws, err := r.client.GetWorkspace(data.WorkspaceId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", data.WorkspaceId.ValueString(), err))
+		return
+	}
+
+	childRepositoryIds, diags := repositoryChildIds(ctx, data.ChildRepositoryIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rp, err := r.client.CreateRepository(ws.Id, repoflow.Repository{
+		Name:                              data.Name.ValueString(),
+		PackageType:                       data.PackageType.ValueString(),
+		RepositoryType:                    data.RepositoryType.ValueString(),
+		RemoteRepositoryUrl:               data.RemoteRepositoryUrl.ValueStringPointer(),
+		IsRemoteCacheEnabled:              remoteCacheEnabledPtr(data.RemoteCacheEnabled),
+		FileCacheTimeTillRevalidation:     factory.Int64PtrToIntPtr(data.FileCacheTimeTillRevalidation),
+		MetadataCacheTimeTillRevalidation: factory.Int64PtrToIntPtr(data.MetadataCacheTimeTillRevalidation),
+		UploadLocalRepositoryId:           data.UploadLocalRepositoryId.ValueStringPointer(),
+		ChildRepositoryIds:                childRepositoryIds,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create repository %s on workspaceId %s, got error: %s", data.Name.ValueString(), ws.Id, err))
+		return
+	}
+
+	resp.Diagnostics.Append(repositoryResourceModelFromApi(ctx, &data, ws.Id, rp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created repository", map[string]interface{}{"id": data.Id.ValueString()})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data RepositoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := data.WorkspaceId.ValueString()
+
+	rp, err := r.client.GetRepository(workspaceId, data.Name.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			// Deleted out-of-band: drop it from state instead of failing the plan.
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read repository %s on workspaceId %s, got error: %s", data.Name.ValueString(), workspaceId, err))
+		return
+	}
+
+	resp.Diagnostics.Append(repositoryResourceModelFromApi(ctx, &data, workspaceId, rp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data RepositoryResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state RepositoryResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspaceId := state.WorkspaceId.ValueString()
+
+	childRepositoryIds, diags := repositoryChildIds(ctx, data.ChildRepositoryIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rp, err := r.client.UpdateRepository(workspaceId, state.RepositoryId.ValueString(), repoflow.Repository{
+		Name:                              data.Name.ValueString(),
+		PackageType:                       data.PackageType.ValueString(),
+		RepositoryType:                    data.RepositoryType.ValueString(),
+		RemoteRepositoryUrl:               data.RemoteRepositoryUrl.ValueStringPointer(),
+		IsRemoteCacheEnabled:              remoteCacheEnabledPtr(data.RemoteCacheEnabled),
+		FileCacheTimeTillRevalidation:     factory.Int64PtrToIntPtr(data.FileCacheTimeTillRevalidation),
+		MetadataCacheTimeTillRevalidation: factory.Int64PtrToIntPtr(data.MetadataCacheTimeTillRevalidation),
+		UploadLocalRepositoryId:           data.UploadLocalRepositoryId.ValueStringPointer(),
+		ChildRepositoryIds:                childRepositoryIds,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update repository %s on workspaceId %s, got error: %s", state.RepositoryId.ValueString(), workspaceId, err))
+		return
+	}
+
+	resp.Diagnostics.Append(repositoryResourceModelFromApi(ctx, &data, workspaceId, rp)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data RepositoryResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteRepository(data.WorkspaceId.ValueString(), data.RepositoryId.ValueString())
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete repository %s on workspaceId %s, got error: %s", data.RepositoryId.ValueString(), data.WorkspaceId.ValueString(), err))
+		return
+	}
+}
+
+func (r *RepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.SplitN(req.ID, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: workspace/name, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workspace"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), parts[1])...)
+}
+
+// remoteCacheEnabledPtr returns the plan's remote_cache_enabled value, or nil
+// when it's null or still unknown. Sending nil rather than coercing an
+// unset/unknown value to false lets the repoflow API apply its own default
+// instead of the provider silently overriding it.
+func remoteCacheEnabledPtr(v types.Bool) *bool {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return v.ValueBoolPointer()
+}
+
+// repositoryChildIds converts the plan's child_repository_ids list into the
+// plain string slice the repoflow API expects.
+func repositoryChildIds(ctx context.Context, list types.List) ([]string, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+
+	var ids []string
+	diags := list.ElementsAs(ctx, &ids, false)
+	return ids, diags
+}
+
+// repositoryResourceModelFromApi copies the repoflow API representation of a
+// repository into data, the same way RepositoryDataSource does for the
+// singular data source.
+func repositoryResourceModelFromApi(ctx context.Context, data *RepositoryResourceModel, workspaceId string, rp *repoflow.Repository) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data.Id = types.StringValue(strings.Join([]string{workspaceId, rp.Id}, "/"))
+	data.RepositoryId = types.StringValue(rp.Id)
+	data.WorkspaceId = types.StringValue(workspaceId)
+
+	data.Name = types.StringValue(rp.Name)
+	data.Status = types.StringValue(rp.Status)
+	data.PackageType = types.StringValue(rp.PackageType)
+	data.RepositoryType = types.StringValue(rp.RepositoryType)
+
+	data.RemoteRepositoryUrl = types.StringPointerValue(rp.RemoteRepositoryUrl)
+	data.RemoteCacheEnabled = types.BoolPointerValue(rp.IsRemoteCacheEnabled)
+
+	data.FileCacheTimeTillRevalidation = types.Int64PointerValue(factory.IntPtrToInt64Ptr(rp.FileCacheTimeTillRevalidation))
+	data.MetadataCacheTimeTillRevalidation = types.Int64PointerValue(factory.IntPtrToInt64Ptr(rp.MetadataCacheTimeTillRevalidation))
+
+	data.UploadLocalRepositoryId = types.StringPointerValue(rp.UploadLocalRepositoryId)
+
+	if rp.ChildRepositories == nil {
+		data.ChildRepositoryIds = types.ListNull(types.StringType)
+	} else {
+		ids := make([]string, len(rp.ChildRepositories))
+		for i, child := range rp.ChildRepositories {
+			ids[i] = child.Id
+		}
+
+		listValue, listDiags := types.ListValueFrom(ctx, types.StringType, ids)
+		diags.Append(listDiags...)
+		if diags.HasError() {
+			return diags
+		}
+		data.ChildRepositoryIds = listValue
+	}
+
+	return diags
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRepositoryResource_basic(t *testing.T) {
+	workspace := os.Getenv("REPOFLOW_TEST_WORKSPACE")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and read back.
+			{
+				Config: testAccRepositoryResourceConfig(workspace, "acc-test-repo", "local", "generic", 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("repoflow_repository.test", "name", "acc-test-repo"),
+					resource.TestCheckResourceAttr("repoflow_repository.test", "workspace", workspace),
+					resource.TestCheckResourceAttr("repoflow_repository.test", "repository_type", "local"),
+					resource.TestCheckResourceAttr("repoflow_repository.test", "package_type", "generic"),
+					resource.TestCheckResourceAttr("repoflow_repository.test", "file_cache_time_till_revalidation", "0"),
+					resource.TestCheckResourceAttrSet("repoflow_repository.test", "repository_id"),
+					resource.TestCheckResourceAttrSet("repoflow_repository.test", "id"),
+				),
+			},
+			// ImportState testing.
+			{
+				ResourceName:      "repoflow_repository.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/%s", workspace, "acc-test-repo"),
+				ImportStateVerify: true,
+			},
+			// Update a mutable attribute in place.
+			{
+				Config: testAccRepositoryResourceConfig(workspace, "acc-test-repo", "local", "generic", 60000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("repoflow_repository.test", "file_cache_time_till_revalidation", "60000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryResourceConfig(workspace, name, repositoryType, packageType string, fileCacheTimeTillRevalidation int) string {
+	return fmt.Sprintf(`
+resource "repoflow_repository" "test" {
+  workspace                         = %[1]q
+  name                              = %[2]q
+  repository_type                   = %[3]q
+  package_type                      = %[4]q
+  file_cache_time_till_revalidation = %[5]d
+}
+`, workspace, name, repositoryType, packageType, fileCacheTimeTillRevalidation)
+}
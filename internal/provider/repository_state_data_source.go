@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &RepositoryStateDataSource{}
+
+func NewRepositoryStateDataSource() datasource.DataSource {
+	return &RepositoryStateDataSource{}
+}
+
+// RepositoryStateDataSource reads live, runtime-only repository metadata
+// (storage size, artifact count, sync status, health...) rather than the
+// declarative configuration RepositoryDataSource exposes. Unlike
+// RepositoryDataSource, it is not meant to be cached across plans: by
+// default it hits the repoflow API on every plan, the same way
+// terraform_remote_state reads the backend on every plan. Setting
+// refresh_interval avoids redundant live calls for the same
+// (workspace, name) pair read by more than one data source block within a
+// single plan/apply; it does not carry over between separate Terraform CLI
+// invocations, since each one starts a fresh provider process with an empty
+// cache.
+type RepositoryStateDataSource struct {
+	client *repoflow.Client
+}
+
+type RepositoryStateDataSourceModel struct {
+	Workspace         types.String  `tfsdk:"workspace"`
+	Name              types.String  `tfsdk:"name"`
+	RefreshInterval   types.Int64   `tfsdk:"refresh_interval"`
+	Id                types.String  `tfsdk:"id"`
+	StorageSizeBytes  types.Int64   `tfsdk:"storage_size_bytes"`
+	ArtifactCount     types.Int64   `tfsdk:"artifact_count"`
+	LastSyncTimestamp types.String  `tfsdk:"last_sync_timestamp"`
+	CacheHitRatio     types.Float64 `tfsdk:"cache_hit_ratio"`
+	Status            types.String  `tfsdk:"status"`
+	LastRefreshed     types.String  `tfsdk:"last_refreshed"`
+}
+
+// repositoryStateCacheEntry holds the last live reading for a given
+// workspace/name pair, so repeated reads within refresh_interval don't have
+// to hit the repoflow API.
+type repositoryStateCacheEntry struct {
+	fetchedAt time.Time
+	state     *repoflow.RepositoryState
+}
+
+var (
+	repositoryStateCacheMu sync.Mutex
+	repositoryStateCache   = map[string]repositoryStateCacheEntry{}
+)
+
+func (d *RepositoryStateDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_state"
+}
+
+func (d *RepositoryStateDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Reads live repository metadata (storage size, artifact count, sync status, health) on every plan, bypassing Terraform state. Useful for wiring alarms, autoscaling or conditional `count`s off real repository health.",
+
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Repository name",
+				Required:            true,
+			},
+			"workspace": schema.StringAttribute{
+				MarkdownDescription: "Workspace the repository belongs to (name or Id)",
+				Required:            true,
+			},
+			"refresh_interval": schema.Int64Attribute{
+				MarkdownDescription: "Number of seconds for which a live reading is reused by other reads of the same repository within a single Terraform run. Readings are not kept between runs, so every plan performs at least one live call. When unset, every read performs a live call.",
+				Optional:            true,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Repository identifier",
+				Computed:            true,
+			},
+			"storage_size_bytes": schema.Int64Attribute{
+				MarkdownDescription: "Current storage size of the repository, in bytes.",
+				Computed:            true,
+			},
+			"artifact_count": schema.Int64Attribute{
+				MarkdownDescription: "Current number of artifacts stored in the repository.",
+				Computed:            true,
+			},
+			"last_sync_timestamp": schema.StringAttribute{
+				MarkdownDescription: "Timestamp of the last successful sync with the upstream (remote repositories only).",
+				Computed:            true,
+			},
+			"cache_hit_ratio": schema.Float64Attribute{
+				MarkdownDescription: "Ratio of cache hits to total requests, between 0 and 1.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Current health/status of the repository.",
+				Computed:            true,
+			},
+			"last_refreshed": schema.StringAttribute{
+				MarkdownDescription: "Timestamp at which this reading was taken.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *RepositoryStateDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*repoflow.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *repoflow.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *RepositoryStateDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data RepositoryStateDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workspace := data.Workspace.ValueString()
+	repository := data.Name.ValueString()
+
+	var workspaceId string
+	if ws, err := d.client.GetWorkspace(workspace); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to get workspace %s, got error: %s", workspace, err))
+		return
+	} else {
+		workspaceId = ws.Id
+	}
+
+	cacheKey := strings.Join([]string{workspaceId, repository}, "/")
+	refreshInterval := time.Duration(data.RefreshInterval.ValueInt64()) * time.Second
+
+	state, fetchedAt, err := d.readRepositoryState(cacheKey, workspaceId, repository, refreshInterval)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf(
+			"Unable to read repository state %s on workspaceId %s, got error: %s", repository, workspaceId, err,
+		))
+		return
+	}
+
+	data.Id = types.StringValue(cacheKey)
+	data.StorageSizeBytes = types.Int64Value(state.StorageSizeBytes)
+	data.ArtifactCount = types.Int64Value(state.ArtifactCount)
+	data.LastSyncTimestamp = types.StringPointerValue(state.LastSyncTimestamp)
+	data.CacheHitRatio = types.Float64Value(state.CacheHitRatio)
+	data.Status = types.StringValue(state.Status)
+	data.LastRefreshed = types.StringValue(fetchedAt.Format(time.RFC3339))
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read repository state data", map[string]interface{}{
+		"name":      repository,
+		"workspace": workspaceId,
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// readRepositoryState returns the repository state for cacheKey, reusing the
+// last live reading when it is younger than refreshInterval. A zero
+// refreshInterval always performs a live call. The cache is process-local,
+// so it is empty at the start of every `terraform plan`/`apply` and only
+// helps within that single run.
+func (d *RepositoryStateDataSource) readRepositoryState(cacheKey, workspaceId, repository string, refreshInterval time.Duration) (*repoflow.RepositoryState, time.Time, error) {
+	repositoryStateCacheMu.Lock()
+	if refreshInterval > 0 {
+		if entry, ok := repositoryStateCache[cacheKey]; ok && time.Since(entry.fetchedAt) < refreshInterval {
+			repositoryStateCacheMu.Unlock()
+			return entry.state, entry.fetchedAt, nil
+		}
+	}
+	repositoryStateCacheMu.Unlock()
+
+	state, err := d.client.GetRepositoryState(workspaceId, repository)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	fetchedAt := time.Now()
+
+	repositoryStateCacheMu.Lock()
+	repositoryStateCache[cacheKey] = repositoryStateCacheEntry{fetchedAt: fetchedAt, state: state}
+	repositoryStateCacheMu.Unlock()
+
+	return state, fetchedAt, nil
+}
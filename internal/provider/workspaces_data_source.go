@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/fe80/go-repoflow/pkg/repoflow"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &WorkspacesDataSource{}
+
+func NewWorkspacesDataSource() datasource.DataSource {
+	return &WorkspacesDataSource{}
+}
+
+// WorkspacesDataSource lists workspaces, walking the repoflow API pagination
+// internally so the caller never has to deal with pages.
+type WorkspacesDataSource struct {
+	client *repoflow.Client
+}
+
+type WorkspaceListItemModel struct {
+	Name types.String `tfsdk:"name"`
+	Id   types.String `tfsdk:"id"`
+}
+
+type WorkspacesDataSourceModel struct {
+	NameRegex  types.String             `tfsdk:"name_regex"`
+	NamePrefix types.String             `tfsdk:"name_prefix"`
+	Id         types.String             `tfsdk:"id"`
+	Ids        types.List               `tfsdk:"ids"`
+	Workspaces []WorkspaceListItemModel `tfsdk:"workspaces"`
+}
+
+func (d *WorkspacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspaces"
+}
+
+func (d *WorkspacesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Lists workspaces, optionally filtered by name.",
+
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only include workspaces whose name matches this regular expression.",
+				Optional:            true,
+			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only include workspaces whose name starts with this prefix.",
+				Optional:            true,
+			},
+			"ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the matched workspaces, for use in `for_each`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of this data source call.",
+				Computed:            true,
+			},
+			"workspaces": schema.ListNestedAttribute{
+				MarkdownDescription: "Matched workspaces.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Workspace name",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Workspace identifier",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WorkspacesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*repoflow.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *repoflow.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WorkspacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data WorkspacesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		re, err := regexp.Compile(data.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid name_regex", fmt.Sprintf("Unable to compile name_regex, got error: %s", err))
+			return
+		}
+		nameRegex = re
+	}
+	namePrefix := data.NamePrefix.ValueString()
+
+	var all []repoflow.Workspace
+	page := 1
+	for {
+		list, err := d.client.ListWorkspaces(page, 100)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list workspaces, got error: %s", err))
+			return
+		}
+
+		all = append(all, list.Items...)
+
+		if !list.HasMore {
+			break
+		}
+		page++
+	}
+
+	var workspaces []WorkspaceListItemModel
+	var ids []string
+	for _, ws := range all {
+		if nameRegex != nil && !nameRegex.MatchString(ws.Name) {
+			continue
+		}
+		if namePrefix != "" && !strings.HasPrefix(ws.Name, namePrefix) {
+			continue
+		}
+
+		workspaces = append(workspaces, WorkspaceListItemModel{
+			Name: types.StringValue(ws.Name),
+			Id:   types.StringValue(ws.Id),
+		})
+		ids = append(ids, ws.Id)
+	}
+
+	data.Workspaces = workspaces
+	data.Id = types.StringValue("workspaces")
+
+	idsValue, idsDiags := types.ListValueFrom(ctx, types.StringType, ids)
+	resp.Diagnostics.Append(idsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Ids = idsValue
+
+	// Write logs using the tflog package
+	// Documentation: https://terraform.io/plugin/log
+	tflog.Trace(ctx, "read workspaces data", map[string]interface{}{
+		"count": len(workspaces),
+	})
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
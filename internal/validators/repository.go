@@ -0,0 +1,50 @@
+// Package validators collects the attribute and cross-field validators
+// shared by the Repository resource and data sources, so both surfaces
+// reject invalid repoflow values before they ever reach the API.
+package validators
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// RepositoryTypes are the repository_type values repoflow accepts.
+var RepositoryTypes = []string{"local", "remote", "virtual"}
+
+// PackageTypes are the package_type values repoflow accepts.
+var PackageTypes = []string{
+	"generic",
+	"npm",
+	"maven",
+	"docker",
+	"pypi",
+	"go",
+	"helm",
+	"nuget",
+	"gems",
+	"cargo",
+	"debian",
+}
+
+// RepositoryTypeValidators rejects any repository_type outside RepositoryTypes.
+func RepositoryTypeValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.OneOf(RepositoryTypes...),
+	}
+}
+
+// PackageTypeValidators rejects any package_type outside PackageTypes.
+func PackageTypeValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.OneOf(PackageTypes...),
+	}
+}
+
+// CacheTimeTillRevalidationValidators rejects negative cache TTLs; a null
+// value still means "cache indefinitely".
+func CacheTimeTillRevalidationValidators() []validator.Int64 {
+	return []validator.Int64{
+		int64validator.AtLeast(0),
+	}
+}
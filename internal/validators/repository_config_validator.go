@@ -0,0 +1,97 @@
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RepositoryConfigValidators returns the cross-field validators that must
+// hold for a repoflow_repository resource config, regardless of what
+// individual attribute validators already enforce:
+//
+//   - remote_repository_url is required iff repository_type is "remote"
+//   - child_repository_ids is required iff repository_type is "virtual"
+//   - upload_local_repository_id, when set, must appear in child_repository_ids
+func RepositoryConfigValidators() []resource.ConfigValidator {
+	return []resource.ConfigValidator{repositoryConfigValidator{}}
+}
+
+type repositoryConfigValidator struct{}
+
+func (v repositoryConfigValidator) Description(ctx context.Context) string {
+	return "Ensures remote_repository_url, child_repository_ids and upload_local_repository_id are set consistently with repository_type."
+}
+
+func (v repositoryConfigValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v repositoryConfigValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var repositoryType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("repository_type"), &repositoryType)...)
+	if resp.Diagnostics.HasError() || repositoryType.IsUnknown() {
+		return
+	}
+
+	var remoteRepositoryUrl types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("remote_repository_url"), &remoteRepositoryUrl)...)
+
+	var childRepositoryIds types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("child_repository_ids"), &childRepositoryIds)...)
+
+	var uploadLocalRepositoryId types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("upload_local_repository_id"), &uploadLocalRepositoryId)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if repositoryType.ValueString() == "remote" && !remoteRepositoryUrl.IsUnknown() &&
+		(remoteRepositoryUrl.IsNull() || remoteRepositoryUrl.ValueString() == "") {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("remote_repository_url"),
+			"Missing Required Attribute",
+			"remote_repository_url is required when repository_type is \"remote\".",
+		)
+	}
+
+	if repositoryType.ValueString() == "virtual" && !childRepositoryIds.IsUnknown() &&
+		(childRepositoryIds.IsNull() || len(childRepositoryIds.Elements()) == 0) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("child_repository_ids"),
+			"Missing Required Attribute",
+			"child_repository_ids is required when repository_type is \"virtual\".",
+		)
+	}
+
+	if !uploadLocalRepositoryId.IsUnknown() && !uploadLocalRepositoryId.IsNull() &&
+		!childRepositoryIds.IsUnknown() {
+		var childIds []string
+		if !childRepositoryIds.IsNull() {
+			resp.Diagnostics.Append(childRepositoryIds.ElementsAs(ctx, &childIds, false)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		found := false
+		for _, id := range childIds {
+			if id == uploadLocalRepositoryId.ValueString() {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("upload_local_repository_id"),
+				"Invalid Attribute Combination",
+				fmt.Sprintf("upload_local_repository_id %q must also appear in child_repository_ids.", uploadLocalRepositoryId.ValueString()),
+			)
+		}
+	}
+}